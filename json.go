@@ -53,13 +53,15 @@ type (
 
 var (
 	// JsonMarshaler is used for json marshaling. Default marshaler uses encoding/json package with no custom options.
-	// Is called only when value is considered not as null
+	// Is called only when value is considered not as null, and only when no RegisterJSONCodec
+	// codec is registered for the concrete T.
 	JsonMarshaler CustomJsonMarshaler = func(value any) ([]byte, error) {
 		return json.Marshal(value)
 	}
 
 	// JsonUnmarshaler is used for json unmarshaling. Default unmarshaler uses encoding/json package with no custom options.
-	// Is called only when b not equals to `null`
+	// Is called only when b not equals to `null`, and only when no RegisterJSONCodec codec is
+	// registered for the concrete T.
 	JsonUnmarshaler CustomJsonUnmarshaler = func(b []byte, dst any) error {
 		return json.Unmarshal(b, dst)
 	}
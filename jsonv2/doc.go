@@ -0,0 +1,5 @@
+// Package jsonv2 integrates null.Type and null.Nullable with the experimental
+// encoding/json v2 API (github.com/go-json-experiment/json). It is kept as a separate
+// module so that depending on it does not pull the experimental package into programs
+// that only use the stable encoding/json support in the parent null package.
+package jsonv2
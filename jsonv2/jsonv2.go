@@ -0,0 +1,109 @@
+package jsonv2
+
+import (
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+
+	null "github.com/alsiberij/alsnull"
+)
+
+type (
+	// Type wraps null.Type[T] and implements json.MarshalerTo/json.UnmarshalerFrom by
+	// streaming tokens directly instead of allocating an intermediate byte slice.
+	Type[T any] struct {
+		null.Type[T]
+	}
+
+	// Nullable wraps null.Nullable[T] and implements json.MarshalerTo/json.UnmarshalerFrom
+	// by streaming tokens directly instead of allocating an intermediate byte slice.
+	Nullable[T any] struct {
+		null.Nullable[T]
+	}
+)
+
+// TypeValue returns not null Type with value.
+func TypeValue[T any](value T) Type[T] {
+	return Type[T]{Type: null.TypeValue(value)}
+}
+
+// NullableValue returns not null Nullable with value.
+func NullableValue[T any](value T) Nullable[T] {
+	return Nullable[T]{Nullable: null.NullableValue(value)}
+}
+
+// IsZero implements the "omitzero" contract, so a json v2 `,omitzero` tag elides null
+// Type fields the same way `omitempty` elides empty fields of ordinary types.
+func (t Type[T]) IsZero() bool {
+	return t.IsNull()
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+func (t Type[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if t.IsNull() {
+		return enc.WriteToken(jsontext.Null)
+	}
+
+	v := t.RawValue()
+
+	return json.MarshalEncode(enc, &v)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+func (t *Type[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+
+		t.SetNull()
+
+		return nil
+	}
+
+	var v T
+	if err := json.UnmarshalDecode(dec, &v); err != nil {
+		return err
+	}
+
+	t.SetValue(v)
+
+	return nil
+}
+
+// IsZero implements the "omitzero" contract, see Type.IsZero.
+func (t Nullable[T]) IsZero() bool {
+	return t.IsNull()
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+func (t Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if t.IsNull() {
+		return enc.WriteToken(jsontext.Null)
+	}
+
+	v := t.RawValue()
+
+	return json.MarshalEncode(enc, &v)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+func (t *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+
+		t.SetNull()
+
+		return nil
+	}
+
+	var v T
+	if err := json.UnmarshalDecode(dec, &v); err != nil {
+		return err
+	}
+
+	t.SetValue(v)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package jsonv2
+
+import (
+	"testing"
+
+	"github.com/go-json-experiment/json"
+)
+
+func TestNullable_RoundTrip(t *testing.T) {
+	n := NullableValue(42)
+
+	b, err := json.Marshal(&n)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(b) != "42" {
+		t.Errorf("Marshal() = %s, want 42", b)
+	}
+
+	var got Nullable[int]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if got.RawValue() != 42 {
+		t.Errorf("Unmarshal() = %d, want 42", got.RawValue())
+	}
+}
+
+func TestNullable_Null(t *testing.T) {
+	var n Nullable[int]
+
+	b, err := json.Marshal(&n)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(b) != "null" {
+		t.Errorf("Marshal() of a null value = %s, want null", b)
+	}
+
+	if !n.IsZero() {
+		t.Errorf("IsZero() should be true for a null value")
+	}
+
+	var got Nullable[int]
+	got.SetValue(7)
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !got.IsNull() {
+		t.Errorf("Unmarshal(null) should mark the value null")
+	}
+}
+
+func TestType_RoundTrip(t *testing.T) {
+	typ := TypeValue("hello")
+
+	b, err := json.Marshal(&typ)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(b) != `"hello"` {
+		t.Errorf("Marshal() = %s, want \"hello\"", b)
+	}
+
+	var got Type[string]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if got.RawValue() != "hello" {
+		t.Errorf("Unmarshal() = %q, want hello", got.RawValue())
+	}
+}
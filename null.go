@@ -89,3 +89,63 @@ func (s *Type[T]) DefaultValue() T {
 	var v T
 	return v
 }
+
+// ValueOrZero returns actual value if Type is not null, default value of T otherwise.
+// It is an alias for RawValue.
+func (s *Type[T]) ValueOrZero() T {
+	return s.RawValue()
+}
+
+// ValueOr returns actual value if Type is not null, fallback otherwise.
+func (s *Type[T]) ValueOr(fallback T) T {
+	if !s.ok {
+		return fallback
+	}
+
+	return s.value
+}
+
+// OrElse returns actual value if Type is not null, result of fallback otherwise.
+func (s *Type[T]) OrElse(fallback func() T) T {
+	if !s.ok {
+		return fallback()
+	}
+
+	return s.value
+}
+
+// MustValue returns actual value if Type is not null, panics otherwise.
+func (s *Type[T]) MustValue() T {
+	if !s.ok {
+		panic("null: MustValue called on null value")
+	}
+
+	return s.value
+}
+
+// Ptr returns nil if Type is null, pointer to a copy of the actual value otherwise.
+// Unlike RawValuePtr, it does not expose the internal state of Type.
+func (s *Type[T]) Ptr() *T {
+	if !s.ok {
+		return nil
+	}
+
+	v := s.value
+	return &v
+}
+
+// IfPresent calls f with the actual value if Type is not null, does nothing otherwise.
+func (s *Type[T]) IfPresent(f func(T)) {
+	if s.ok {
+		f(s.value)
+	}
+}
+
+// Map returns TypeValue(f(in.RawValue())) if in is not null, null Type[B] otherwise.
+func Map[A, B any](in Type[A], f func(A) B) Type[B] {
+	if in.IsNull() {
+		return Type[B]{}
+	}
+
+	return TypeValue(f(in.RawValue()))
+}
@@ -0,0 +1,124 @@
+package null
+
+import "testing"
+
+func TestType_ValueOrZero(t *testing.T) {
+	var n Type[int]
+	if v := n.ValueOrZero(); v != 0 {
+		t.Errorf("ValueOrZero() on null = %d, want 0", v)
+	}
+
+	n.SetValue(7)
+	if v := n.ValueOrZero(); v != 7 {
+		t.Errorf("ValueOrZero() = %d, want 7", v)
+	}
+}
+
+func TestType_ValueOr(t *testing.T) {
+	var n Type[int]
+	if v := n.ValueOr(9); v != 9 {
+		t.Errorf("ValueOr(9) on null = %d, want 9", v)
+	}
+
+	n.SetValue(7)
+	if v := n.ValueOr(9); v != 7 {
+		t.Errorf("ValueOr(9) = %d, want 7", v)
+	}
+}
+
+func TestType_OrElse(t *testing.T) {
+	calls := 0
+	fallback := func() int {
+		calls++
+		return 9
+	}
+
+	var n Type[int]
+	if v := n.OrElse(fallback); v != 9 {
+		t.Errorf("OrElse() on null = %d, want 9", v)
+	}
+	if calls != 1 {
+		t.Errorf("OrElse() should call fallback once when null, got %d calls", calls)
+	}
+
+	n.SetValue(7)
+	if v := n.OrElse(fallback); v != 7 {
+		t.Errorf("OrElse() = %d, want 7", v)
+	}
+	if calls != 1 {
+		t.Errorf("OrElse() should not call fallback when not null, got %d calls", calls)
+	}
+}
+
+func TestType_MustValue(t *testing.T) {
+	n := TypeValue(7)
+	if v := n.MustValue(); v != 7 {
+		t.Errorf("MustValue() = %d, want 7", v)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustValue() on null should panic")
+		}
+	}()
+
+	var null Type[int]
+	null.MustValue()
+}
+
+func TestType_Ptr(t *testing.T) {
+	var n Type[int]
+	if p := n.Ptr(); p != nil {
+		t.Errorf("Ptr() on null = %v, want nil", p)
+	}
+
+	n.SetValue(7)
+	p := n.Ptr()
+	if p == nil || *p != 7 {
+		t.Fatalf("Ptr() = %v, want pointer to 7", p)
+	}
+
+	*p = 9
+	if n.RawValue() != 7 {
+		t.Errorf("Ptr() should return a pointer to a copy, mutating it changed the original to %d", n.RawValue())
+	}
+}
+
+func TestType_IfPresent(t *testing.T) {
+	calls := 0
+
+	var n Type[int]
+	n.IfPresent(func(int) { calls++ })
+	if calls != 0 {
+		t.Errorf("IfPresent() should not call f on null, got %d calls", calls)
+	}
+
+	n.SetValue(7)
+	var seen int
+	n.IfPresent(func(v int) { seen = v })
+	if seen != 7 {
+		t.Errorf("IfPresent() saw %d, want 7", seen)
+	}
+}
+
+func TestMap(t *testing.T) {
+	in := TypeValue(7)
+	out := Map(in, func(v int) string {
+		if v == 7 {
+			return "seven"
+		}
+		return "other"
+	})
+	if out.IsNull() {
+		t.Fatalf("Map() of a non-null Type produced a null result")
+	}
+	if out.RawValue() != "seven" {
+		t.Errorf("Map() = %q, want %q", out.RawValue(), "seven")
+	}
+
+	var null Type[int]
+	nullOut := Map(null, func(v int) string { return "unreachable" })
+	if !nullOut.IsNull() {
+		t.Errorf("Map() of a null Type should produce a null result")
+	}
+}
@@ -2,7 +2,8 @@ package null
 
 import (
 	"database/sql/driver"
-	"encoding/json"
+	"encoding"
+	"strconv"
 	"time"
 )
 
@@ -44,7 +45,11 @@ func (t Nullable[T]) MarshalJSON() ([]byte, error) {
 		return nullBytes, nil
 	}
 
-	return json.Marshal(&t.value)
+	if codec, ok := lookupJSONCodec[T](); ok {
+		return codec.marshal(t.value)
+	}
+
+	return JsonMarshaler(t.value)
 }
 
 func (t *Nullable[T]) UnmarshalJSON(bytes []byte) error {
@@ -55,7 +60,19 @@ func (t *Nullable[T]) UnmarshalJSON(bytes []byte) error {
 	}
 
 	var v T
-	err := json.Unmarshal(bytes, &v)
+
+	if codec, ok := lookupJSONCodec[T](); ok {
+		if err := codec.unmarshal(bytes, &v); err != nil {
+			return err
+		}
+
+		t.value = v
+		t.ok = true
+
+		return nil
+	}
+
+	err := JsonUnmarshaler(bytes, &v)
 	if err != nil {
 		return err
 	}
@@ -96,6 +113,50 @@ func (t Nullable[T]) Value() (driver.Value, error) {
 		value = v
 	case time.Time:
 		value = v
+	case []int64:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = pgFormatInt64(e)
+		}
+		value = pgEncodeArray(elems)
+	case []string:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = pgQuoteArrayElement(e)
+		}
+		value = pgEncodeArray(elems)
+	case []float64:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = pgFormatFloat64(e)
+		}
+		value = pgEncodeArray(elems)
+	case []bool:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = pgFormatBool(e)
+		}
+		value = pgEncodeArray(elems)
+	case []time.Time:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = pgQuoteArrayElement(pgFormatTime(e))
+		}
+		value = pgEncodeArray(elems)
+	case [][]byte:
+		// Known limitation: elements are embedded as raw bytes quoted the same way as a
+		// []string array, not as Postgres bytea literals (`\x...` hex or octal escapes).
+		// This round-trips correctly against Nullable[[][]byte].Scan below, but the literal
+		// produced here is not a valid bytea[] literal for a real Postgres bytea column.
+		elems := make([]string, len(v))
+		for i, e := range v {
+			if e == nil {
+				elems[i] = "NULL"
+			} else {
+				elems[i] = pgQuoteArrayElement(string(e))
+			}
+		}
+		value = pgEncodeArray(elems)
 	default:
 		return nil, ErrTypeIsNotSupported
 	}
@@ -108,7 +169,9 @@ func (t Nullable[T]) Value() (driver.Value, error) {
 }
 
 // Scan implements sql.Scanner. Supported T are:
-// int, int32, int64, uint, uint32, uint64, float32, float64, bool, []byte, string, time.Time
+// int, int32, int64, uint, uint32, uint64, float32, float64, bool, []byte, string, time.Time,
+// and their Postgres array counterparts []int64, []string, []float64, []bool, []time.Time, [][]byte
+// (parsed from the `{a,b,c}` literal delivered by both pgx ([]byte) and lib/pq (string)).
 func (t *Nullable[T]) Scan(src any) error {
 	switch ptr := interface{}(&t.value).(type) {
 	case *int:
@@ -255,9 +318,475 @@ func (t *Nullable[T]) Scan(src any) error {
 			t.value = t.DefaultValue()
 			t.ok = false
 		}
+	case *[]int64:
+		if src == nil {
+			t.value = t.DefaultValue()
+			t.ok = false
+			break
+		}
+
+		literal, ok := pgArraySource(src)
+		if !ok {
+			return ErrScanningTypeMismatch
+		}
+
+		elems, err := pgDecodeArray(literal)
+		if err != nil {
+			return err
+		}
+
+		out := make([]int64, len(elems))
+		for i, e := range elems {
+			if e.isNull {
+				continue
+			}
+
+			n, err := strconv.ParseInt(e.value, 10, 64)
+			if err != nil {
+				return err
+			}
+			out[i] = n
+		}
+
+		*ptr = out
+		t.ok = true
+	case *[]string:
+		if src == nil {
+			t.value = t.DefaultValue()
+			t.ok = false
+			break
+		}
+
+		literal, ok := pgArraySource(src)
+		if !ok {
+			return ErrScanningTypeMismatch
+		}
+
+		elems, err := pgDecodeArray(literal)
+		if err != nil {
+			return err
+		}
+
+		out := make([]string, len(elems))
+		for i, e := range elems {
+			if !e.isNull {
+				out[i] = e.value
+			}
+		}
+
+		*ptr = out
+		t.ok = true
+	case *[]float64:
+		if src == nil {
+			t.value = t.DefaultValue()
+			t.ok = false
+			break
+		}
+
+		literal, ok := pgArraySource(src)
+		if !ok {
+			return ErrScanningTypeMismatch
+		}
+
+		elems, err := pgDecodeArray(literal)
+		if err != nil {
+			return err
+		}
+
+		out := make([]float64, len(elems))
+		for i, e := range elems {
+			if e.isNull {
+				continue
+			}
+
+			f, err := strconv.ParseFloat(e.value, 64)
+			if err != nil {
+				return err
+			}
+			out[i] = f
+		}
+
+		*ptr = out
+		t.ok = true
+	case *[]bool:
+		if src == nil {
+			t.value = t.DefaultValue()
+			t.ok = false
+			break
+		}
+
+		literal, ok := pgArraySource(src)
+		if !ok {
+			return ErrScanningTypeMismatch
+		}
+
+		elems, err := pgDecodeArray(literal)
+		if err != nil {
+			return err
+		}
+
+		out := make([]bool, len(elems))
+		for i, e := range elems {
+			if e.isNull {
+				continue
+			}
+
+			b, err := strconv.ParseBool(e.value)
+			if err != nil {
+				return err
+			}
+			out[i] = b
+		}
+
+		*ptr = out
+		t.ok = true
+	case *[]time.Time:
+		if src == nil {
+			t.value = t.DefaultValue()
+			t.ok = false
+			break
+		}
+
+		literal, ok := pgArraySource(src)
+		if !ok {
+			return ErrScanningTypeMismatch
+		}
+
+		elems, err := pgDecodeArray(literal)
+		if err != nil {
+			return err
+		}
+
+		out := make([]time.Time, len(elems))
+		for i, e := range elems {
+			if e.isNull {
+				continue
+			}
+
+			ts, err := pgParseTime(e.value)
+			if err != nil {
+				return err
+			}
+			out[i] = ts
+		}
+
+		*ptr = out
+		t.ok = true
+	case *[][]byte:
+		if src == nil {
+			t.value = t.DefaultValue()
+			t.ok = false
+			break
+		}
+
+		literal, ok := pgArraySource(src)
+		if !ok {
+			return ErrScanningTypeMismatch
+		}
+
+		elems, err := pgDecodeArray(literal)
+		if err != nil {
+			return err
+		}
+
+		out := make([][]byte, len(elems))
+		for i, e := range elems {
+			if !e.isNull {
+				out[i] = []byte(e.value)
+			}
+		}
+
+		*ptr = out
+		t.ok = true
 	default:
 		return ErrScanningTypeMismatch
 	}
 
 	return nil
 }
+
+// MarshalText implements encoding.TextMarshaler. Null value marshals to an empty slice.
+// If T implements encoding.TextMarshaler, it is used directly. Otherwise, supported T are:
+// int, int32, int64, uint, uint32, uint64, float32, float64, bool, []byte, string, time.Time
+//
+// Text has no wire-level concept of null, so this encoding is ambiguous for T = string or
+// T = []byte: a non-null empty value and a null value both marshal to an empty slice and are
+// therefore indistinguishable once round-tripped through UnmarshalText. Prefer the JSON or
+// SQL methods for those T when the empty/null distinction must be preserved.
+func (t Nullable[T]) MarshalText() ([]byte, error) {
+	if !t.ok {
+		return []byte{}, nil
+	}
+
+	if m, ok := interface{}(&t.value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	switch v := interface{}(t.value).(type) {
+	case int:
+		return strconv.AppendInt(nil, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(nil, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(nil, v, 10), nil
+	case uint:
+		return strconv.AppendUint(nil, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(nil, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(nil, v, 10), nil
+	case float32:
+		return strconv.AppendFloat(nil, float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.AppendFloat(nil, v, 'f', -1, 64), nil
+	case bool:
+		return strconv.AppendBool(nil, v), nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, ErrTypeIsNotSupported
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty slice marks the value as null.
+// If T implements encoding.TextUnmarshaler, it is used directly. Otherwise, supported T are:
+// int, int32, int64, uint, uint32, uint64, float32, float64, bool, []byte, string, time.Time
+//
+// For T = string or T = []byte, a non-null empty value is indistinguishable from null (see
+// MarshalText) and is deliberately treated as null here rather than silently accepted as a
+// non-null empty value, since there is no way to tell the two apart from the slice alone.
+func (t *Nullable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	if m, ok := interface{}(&t.value).(encoding.TextUnmarshaler); ok {
+		if err := m.UnmarshalText(text); err != nil {
+			return err
+		}
+		t.ok = true
+		return nil
+	}
+
+	switch ptr := interface{}(&t.value).(type) {
+	case *int:
+		v, err := strconv.ParseInt(string(text), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = int(v)
+	case *int32:
+		v, err := strconv.ParseInt(string(text), 10, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = int32(v)
+	case *int64:
+		v, err := strconv.ParseInt(string(text), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *uint:
+		v, err := strconv.ParseUint(string(text), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = uint(v)
+	case *uint32:
+		v, err := strconv.ParseUint(string(text), 10, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = uint32(v)
+	case *uint64:
+		v, err := strconv.ParseUint(string(text), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *float32:
+		v, err := strconv.ParseFloat(string(text), 32)
+		if err != nil {
+			return err
+		}
+		*ptr = float32(v)
+	case *float64:
+		v, err := strconv.ParseFloat(string(text), 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *bool:
+		v, err := strconv.ParseBool(string(text))
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *[]byte:
+		b := make([]byte, len(text))
+		copy(b, text)
+		*ptr = b
+	case *string:
+		*ptr = string(text)
+	default:
+		return ErrTypeIsNotSupported
+	}
+
+	t.ok = true
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The result is a leading tag byte
+// (binaryTagNull or binaryTagValue) followed by the payload. If T implements
+// encoding.BinaryMarshaler, it is used to produce the payload. Otherwise, supported T are:
+// int, int32, int64, uint, uint32, uint64, float32, float64, bool, []byte, string, time.Time
+func (t Nullable[T]) MarshalBinary() ([]byte, error) {
+	if !t.ok {
+		return []byte{binaryTagNull}, nil
+	}
+
+	if m, ok := interface{}(&t.value).(encoding.BinaryMarshaler); ok {
+		payload, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte{binaryTagValue}, payload...), nil
+	}
+
+	var payload []byte
+
+	switch v := interface{}(t.value).(type) {
+	case int:
+		payload = strconv.AppendInt(nil, int64(v), 10)
+	case int32:
+		payload = strconv.AppendInt(nil, int64(v), 10)
+	case int64:
+		payload = strconv.AppendInt(nil, v, 10)
+	case uint:
+		payload = strconv.AppendUint(nil, uint64(v), 10)
+	case uint32:
+		payload = strconv.AppendUint(nil, uint64(v), 10)
+	case uint64:
+		payload = strconv.AppendUint(nil, v, 10)
+	case float32:
+		payload = strconv.AppendFloat(nil, float64(v), 'f', -1, 32)
+	case float64:
+		payload = strconv.AppendFloat(nil, v, 'f', -1, 64)
+	case bool:
+		payload = strconv.AppendBool(nil, v)
+	case []byte:
+		payload = v
+	case string:
+		payload = []byte(v)
+	default:
+		return nil, ErrTypeIsNotSupported
+	}
+
+	return append([]byte{binaryTagValue}, payload...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, reversing MarshalBinary. If T
+// implements encoding.BinaryUnmarshaler, it is used to consume the payload. Otherwise,
+// supported T are: int, int32, int64, uint, uint32, uint64, float32, float64, bool, []byte,
+// string, time.Time
+func (t *Nullable[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrBinaryDataIsEmpty
+	}
+
+	tag, payload := data[0], data[1:]
+
+	if tag == binaryTagNull {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	if tag != binaryTagValue {
+		return ErrUnknownBinaryTag
+	}
+
+	if m, ok := interface{}(&t.value).(encoding.BinaryUnmarshaler); ok {
+		if err := m.UnmarshalBinary(payload); err != nil {
+			return err
+		}
+		t.ok = true
+		return nil
+	}
+
+	switch ptr := interface{}(&t.value).(type) {
+	case *int:
+		v, err := strconv.ParseInt(string(payload), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = int(v)
+	case *int32:
+		v, err := strconv.ParseInt(string(payload), 10, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = int32(v)
+	case *int64:
+		v, err := strconv.ParseInt(string(payload), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *uint:
+		v, err := strconv.ParseUint(string(payload), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = uint(v)
+	case *uint32:
+		v, err := strconv.ParseUint(string(payload), 10, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = uint32(v)
+	case *uint64:
+		v, err := strconv.ParseUint(string(payload), 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *float32:
+		v, err := strconv.ParseFloat(string(payload), 32)
+		if err != nil {
+			return err
+		}
+		*ptr = float32(v)
+	case *float64:
+		v, err := strconv.ParseFloat(string(payload), 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *bool:
+		v, err := strconv.ParseBool(string(payload))
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *[]byte:
+		b := make([]byte, len(payload))
+		copy(b, payload)
+		*ptr = b
+	case *string:
+		*ptr = string(payload)
+	default:
+		return ErrTypeIsNotSupported
+	}
+
+	t.ok = true
+
+	return nil
+}
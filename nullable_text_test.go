@@ -0,0 +1,117 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNullableText_RoundTrip(t *testing.T) {
+	n := NullableValue(42)
+
+	text, err := n.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error: %v", err)
+	}
+	if string(text) != "42" {
+		t.Errorf("MarshalText() = %q, want %q", text, "42")
+	}
+
+	var got Nullable[int]
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error: %v", err)
+	}
+	if got.RawValue() != 42 {
+		t.Errorf("UnmarshalText() = %d, want 42", got.RawValue())
+	}
+
+	var null Nullable[int]
+	nullText, err := null.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error: %v", err)
+	}
+	if len(nullText) != 0 {
+		t.Errorf("MarshalText() of a null value = %q, want empty", nullText)
+	}
+
+	var roundTripped Nullable[int]
+	if err := roundTripped.UnmarshalText(nullText); err != nil {
+		t.Fatalf("UnmarshalText() error: %v", err)
+	}
+	if !roundTripped.IsNull() {
+		t.Errorf("UnmarshalText() of an empty slice should mark the value null")
+	}
+}
+
+func TestNullableText_Time(t *testing.T) {
+	ts := time.Date(2023, 7, 17, 12, 30, 0, 0, time.UTC)
+	n := NullableValue(ts)
+
+	text, err := n.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error: %v", err)
+	}
+
+	var got Nullable[time.Time]
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("UnmarshalText() = %v, want %v", got.RawValue(), ts)
+	}
+}
+
+// TestNullableText_EmptyStringAmbiguity pins down the documented limitation on MarshalText:
+// for T = string (and T = []byte), a non-null empty value is indistinguishable from null.
+func TestNullableText_EmptyStringAmbiguity(t *testing.T) {
+	n := NullableValue("")
+
+	text, err := n.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error: %v", err)
+	}
+	if len(text) != 0 {
+		t.Errorf("MarshalText() of a non-null empty string = %q, want empty", text)
+	}
+
+	var got Nullable[string]
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error: %v", err)
+	}
+	if !got.IsNull() {
+		t.Errorf("UnmarshalText() was expected to collapse the empty string to null (documented limitation)")
+	}
+}
+
+func TestNullableBinary_RoundTrip(t *testing.T) {
+	n := NullableValue("")
+
+	data, err := n.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	var got Nullable[string]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error: %v", err)
+	}
+	if got.IsNull() {
+		t.Errorf("UnmarshalBinary() should not treat a non-null empty string as null")
+	}
+	if got.RawValue() != "" {
+		t.Errorf("UnmarshalBinary() = %q, want empty string", got.RawValue())
+	}
+
+	var null Nullable[string]
+	nullData, err := null.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	var roundTripped Nullable[string]
+	if err := roundTripped.UnmarshalBinary(nullData); err != nil {
+		t.Fatalf("UnmarshalBinary() error: %v", err)
+	}
+	if !roundTripped.IsNull() {
+		t.Errorf("UnmarshalBinary() of a marshaled null value should stay null")
+	}
+}
@@ -0,0 +1,139 @@
+package null
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// pgArrayElem is a single decoded token of a Postgres array literal, together with
+	// whether it was the unquoted NULL keyword (a nested null, as opposed to the literal
+	// string "NULL", which arrives quoted).
+	pgArrayElem struct {
+		value  string
+		isNull bool
+	}
+)
+
+// pgQuoteArrayElement quotes s for inclusion in a Postgres array literal, escaping
+// backslashes and double quotes as Postgres expects.
+func pgQuoteArrayElement(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' || c == '"' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// pgEncodeArray builds a Postgres array literal ({a,b,c}) from already-formatted elements.
+func pgEncodeArray(elems []string) string {
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
+// pgArraySource extracts the raw array literal from a driver value delivered either as a
+// string (lib/pq) or as a byte slice (pgx).
+func pgArraySource(src any) (string, bool) {
+	switch v := src.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
+
+// pgDecodeArray splits a Postgres array literal ({a,b,c} / {"a","b"}) into its raw element
+// tokens, honouring quoted strings with backslash escapes and the unquoted NULL keyword.
+// Nested arrays ({{a,b},{c,d}}) are not expanded; the inner braces are kept as part of the token.
+func pgDecodeArray(literal string) ([]pgArrayElem, error) {
+	literal = strings.TrimSpace(literal)
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return nil, ErrInvalidPgArrayLiteral
+	}
+
+	body := literal[1 : len(literal)-1]
+	if body == "" {
+		return nil, nil
+	}
+
+	var (
+		elems      []pgArrayElem
+		current    strings.Builder
+		quoted     bool
+		everQuoted bool
+		escaped    bool
+		depth      int
+	)
+
+	flush := func() {
+		if !everQuoted && depth == 0 && current.String() == "NULL" {
+			elems = append(elems, pgArrayElem{isNull: true})
+		} else {
+			elems = append(elems, pgArrayElem{value: current.String()})
+		}
+		current.Reset()
+		everQuoted = false
+	}
+
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+
+		switch {
+		case escaped:
+			current.WriteByte(c)
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+			everQuoted = true
+		case !quoted && c == '{':
+			depth++
+			current.WriteByte(c)
+		case !quoted && c == '}':
+			depth--
+			current.WriteByte(c)
+		case !quoted && c == ',' && depth == 0:
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+
+	return elems, nil
+}
+
+// pgFormatTime/pgParseTime keep Nullable[[]time.Time] round-trips self-consistent,
+// independent of the server's actual timestamp output format.
+const pgTimeLayout = time.RFC3339Nano
+
+func pgFormatTime(t time.Time) string {
+	return t.Format(pgTimeLayout)
+}
+
+func pgParseTime(s string) (time.Time, error) {
+	return time.Parse(pgTimeLayout, s)
+}
+
+func pgFormatInt64(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func pgFormatFloat64(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func pgFormatBool(v bool) string {
+	return strconv.FormatBool(v)
+}
@@ -0,0 +1,110 @@
+package null
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// pgArrayRoundTrip drives a Nullable[T] through Value() and Scan() for both the string
+// (lib/pq) and []byte (pgx) delivery paths, and asserts the result matches eq.
+func pgArrayRoundTrip[T any](t *testing.T, n Nullable[T], eq func(got, want T) bool) {
+	t.Helper()
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+
+	literal, ok := v.(string)
+	if !ok {
+		t.Fatalf("Value() returned %T, want string", v)
+	}
+
+	for _, src := range []any{literal, []byte(literal)} {
+		var got Nullable[T]
+		if err := got.Scan(src); err != nil {
+			t.Fatalf("Scan(%T) error: %v", src, err)
+		}
+		if !eq(got.RawValue(), n.RawValue()) {
+			t.Errorf("Scan(%T) = %#v, want %#v", src, got.RawValue(), n.RawValue())
+		}
+	}
+}
+
+func deepEqual[T any](got, want T) bool {
+	return reflect.DeepEqual(got, want)
+}
+
+func TestNullablePgArray_RoundTrip_Int64(t *testing.T) {
+	pgArrayRoundTrip(t, NullableValue([]int64{1, 2, -3}), deepEqual[[]int64])
+}
+
+func TestNullablePgArray_RoundTrip_String(t *testing.T) {
+	pgArrayRoundTrip(t, NullableValue([]string{"a,b", `c"d`, "e"}), deepEqual[[]string])
+}
+
+func TestNullablePgArray_RoundTrip_Float64(t *testing.T) {
+	pgArrayRoundTrip(t, NullableValue([]float64{1.5, -2, 0}), deepEqual[[]float64])
+}
+
+func TestNullablePgArray_RoundTrip_Bool(t *testing.T) {
+	pgArrayRoundTrip(t, NullableValue([]bool{true, false}), deepEqual[[]bool])
+}
+
+func TestNullablePgArray_RoundTrip_Time(t *testing.T) {
+	ts := time.Date(2023, 7, 17, 12, 30, 0, 0, time.UTC)
+
+	pgArrayRoundTrip(t, NullableValue([]time.Time{ts}), func(got, want []time.Time) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range got {
+			if !got[i].Equal(want[i]) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestNullablePgArray_RoundTrip_Bytes(t *testing.T) {
+	pgArrayRoundTrip(t, NullableValue([][]byte{[]byte("a"), []byte(`b"c`)}), deepEqual[[][]byte])
+}
+
+func TestNullablePgArray_NestedNull(t *testing.T) {
+	var got Nullable[[]string]
+	if err := got.Scan(`{a,NULL,"NULL",c}`); err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+
+	want := []string{"a", "", "NULL", "c"}
+	if !reflect.DeepEqual(got.RawValue(), want) {
+		t.Errorf("Scan() = %#v, want %#v", got.RawValue(), want)
+	}
+}
+
+func TestNullablePgArray_Null(t *testing.T) {
+	var n Nullable[[]int64]
+	if err := n.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) error: %v", err)
+	}
+	if !n.IsNull() {
+		t.Errorf("Scan(nil) should leave the Nullable null")
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+	if v != nil {
+		t.Errorf("Value() = %v, want nil", v)
+	}
+}
+
+func TestNullablePgArray_InvalidLiteral(t *testing.T) {
+	var n Nullable[[]int64]
+	if err := n.Scan("not-an-array"); err == nil {
+		t.Errorf("Scan() with an invalid literal should return an error")
+	}
+}
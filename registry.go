@@ -0,0 +1,50 @@
+package null
+
+import (
+	"reflect"
+	"sync"
+)
+
+type (
+	jsonCodec struct {
+		marshal   func(any) ([]byte, error)
+		unmarshal func([]byte, any) error
+	}
+)
+
+// jsonCodecRegistry holds per-type JSON codecs registered via RegisterJSONCodec, keyed by reflect.Type.
+var jsonCodecRegistry sync.Map
+
+// RegisterJSONCodec registers a custom JSON marshal/unmarshal pair for T. Every Nullable[T]
+// uses this codec instead of JsonMarshaler/JsonUnmarshaler once registered, without requiring
+// a custom wrapper type. Registering for T again replaces the previously registered codec.
+func RegisterJSONCodec[T any](marshal func(T) ([]byte, error), unmarshal func([]byte, *T) error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	jsonCodecRegistry.Store(t, jsonCodec{
+		marshal: func(src any) ([]byte, error) {
+			return marshal(src.(T))
+		},
+		unmarshal: func(b []byte, dst any) error {
+			return unmarshal(b, dst.(*T))
+		},
+	})
+}
+
+// UnregisterJSONCodec removes the custom JSON codec previously registered for T, if any.
+func UnregisterJSONCodec[T any]() {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	jsonCodecRegistry.Delete(t)
+}
+
+// lookupJSONCodec returns the codec registered for T, if any.
+func lookupJSONCodec[T any]() (jsonCodec, bool) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	v, ok := jsonCodecRegistry.Load(t)
+	if !ok {
+		return jsonCodec{}, false
+	}
+
+	return v.(jsonCodec), true
+}
@@ -0,0 +1,72 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegisterJSONCodec(t *testing.T) {
+	const layout = "2006-01-02"
+
+	RegisterJSONCodec(
+		func(v time.Time) ([]byte, error) {
+			return []byte(`"` + v.Format(layout) + `"`), nil
+		},
+		func(b []byte, dst *time.Time) error {
+			ts, err := time.Parse(`"`+layout+`"`, string(b))
+			if err != nil {
+				return err
+			}
+			*dst = ts
+			return nil
+		},
+	)
+	defer UnregisterJSONCodec[time.Time]()
+
+	ts := time.Date(2023, 7, 17, 0, 0, 0, 0, time.UTC)
+	n := NullableValue(ts)
+
+	b, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(b) != `"2023-07-17"` {
+		t.Errorf("MarshalJSON() = %s, want \"2023-07-17\" (registered codec should take priority)", b)
+	}
+
+	var got Nullable[time.Time]
+	if err := got.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got.RawValue(), ts)
+	}
+
+	// Registering for time.Time must not affect other types.
+	other := NullableValue(42)
+	ob, err := other.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(ob) != "42" {
+		t.Errorf("MarshalJSON() of an unrelated T = %s, want 42", ob)
+	}
+}
+
+func TestUnregisterJSONCodec(t *testing.T) {
+	RegisterJSONCodec(
+		func(v int) ([]byte, error) { return []byte(`"custom"`), nil },
+		func(b []byte, dst *int) error { return nil },
+	)
+
+	UnregisterJSONCodec[int]()
+
+	n := NullableValue(42)
+	b, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(b) != "42" {
+		t.Errorf("MarshalJSON() after UnregisterJSONCodec = %s, want 42 (falls back to JsonMarshaler)", b)
+	}
+}
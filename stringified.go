@@ -0,0 +1,279 @@
+package null
+
+import (
+	"database/sql/driver"
+	"strconv"
+)
+
+type (
+	// Stringified is a nullable numeric value that marshals to/from JSON as a quoted string
+	// instead of a bare numeric token, mirroring the encoding/json `,string` tag option.
+	// Null still marshals to the bare `null` token. Supported T are:
+	// int, int32, int64, uint, uint32, uint64, float32, float64
+	Stringified[T any] struct {
+		Type[T]
+	}
+)
+
+// StringifiedValue returns not null Stringified with value.
+func StringifiedValue[T any](value T) Stringified[T] {
+	return Stringified[T]{
+		Type: Type[T]{
+			value: value,
+			ok:    true,
+		},
+	}
+}
+
+// StringifiedValueFromPtr returns null Stringified if valuePtr is nil, Stringified with actual value otherwise.
+func StringifiedValueFromPtr[T any](valuePtr *T) Stringified[T] {
+	if valuePtr == nil {
+		return Stringified[T]{}
+	}
+
+	return Stringified[T]{
+		Type: Type[T]{
+			value: *valuePtr,
+			ok:    true,
+		},
+	}
+}
+
+func (t Stringified[T]) MarshalJSON() ([]byte, error) {
+	if !t.ok {
+		return nullBytes, nil
+	}
+
+	var s string
+
+	switch v := interface{}(t.value).(type) {
+	case int:
+		s = strconv.FormatInt(int64(v), 10)
+	case int32:
+		s = strconv.FormatInt(int64(v), 10)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case uint:
+		s = strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		s = strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		s = strconv.FormatUint(v, 10)
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return nil, ErrTypeIsNotSupported
+	}
+
+	return strconv.AppendQuote(nil, s), nil
+}
+
+// UnmarshalJSON accepts both a quoted numeric string (`"42"`) and a bare numeric token (`42`).
+// Any other token (e.g. a bool or object) is rejected rather than silently ignored.
+func (t *Stringified[T]) UnmarshalJSON(bytes []byte) error {
+	if string(bytes) == nullString {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	s := string(bytes)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return err
+		}
+		s = unquoted
+	}
+
+	switch ptr := interface{}(&t.value).(type) {
+	case *int:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = int(v)
+	case *int32:
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = int32(v)
+	case *int64:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *uint:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = uint(v)
+	case *uint32:
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = uint32(v)
+	case *uint64:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	case *float32:
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return err
+		}
+		*ptr = float32(v)
+	case *float64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		*ptr = v
+	default:
+		return ErrTypeIsNotSupported
+	}
+
+	t.ok = true
+
+	return nil
+}
+
+// Value implements driver.Valuer. Supported T are:
+// int, int32, int64, uint, uint32, uint64, float32, float64
+func (t Stringified[T]) Value() (driver.Value, error) {
+	var value driver.Value
+
+	switch v := interface{}(t.value).(type) {
+	case int:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case int64:
+		value = v
+	case uint:
+		value = int64(v)
+	case uint32:
+		value = int64(v)
+	case uint64:
+		value = int64(v)
+	case float32:
+		value = float64(v)
+	case float64:
+		value = v
+	default:
+		return nil, ErrTypeIsNotSupported
+	}
+
+	if !t.ok {
+		return nil, nil
+	}
+
+	return value, nil
+}
+
+// Scan implements sql.Scanner. Supported T are:
+// int, int32, int64, uint, uint32, uint64, float32, float64
+func (t *Stringified[T]) Scan(src any) error {
+	switch ptr := interface{}(&t.value).(type) {
+	case *int:
+		if src != nil {
+			value, ok := src.(int64)
+			if ok {
+				*ptr = int(value)
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *int32:
+		if src != nil {
+			value, ok := src.(int64)
+			if ok {
+				*ptr = int32(value)
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *int64:
+		if src != nil {
+			value, ok := src.(int64)
+			if ok {
+				*ptr = value
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *uint:
+		if src != nil {
+			value, ok := src.(int64)
+			if ok {
+				*ptr = uint(value)
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *uint32:
+		if src != nil {
+			value, ok := src.(int64)
+			if ok {
+				*ptr = uint32(value)
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *uint64:
+		if src != nil {
+			value, ok := src.(int64)
+			if ok {
+				*ptr = uint64(value)
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *float32:
+		if src != nil {
+			value, ok := src.(float64)
+			if ok {
+				*ptr = float32(value)
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	case *float64:
+		if src != nil {
+			value, ok := src.(float64)
+			if ok {
+				*ptr = value
+				t.ok = true
+			}
+		} else {
+			t.value = t.DefaultValue()
+			t.ok = false
+		}
+	default:
+		return ErrScanningTypeMismatch
+	}
+
+	return nil
+}
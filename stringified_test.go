@@ -0,0 +1,83 @@
+package null
+
+import "testing"
+
+func TestStringified_Marshal(t *testing.T) {
+	n := StringifiedValue(int64(42))
+
+	b, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(b) != `"42"` {
+		t.Errorf("MarshalJSON() = %s, want \"42\"", b)
+	}
+
+	var null Stringified[int64]
+	nullBytes, err := null.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(nullBytes) != "null" {
+		t.Errorf("MarshalJSON() of a null value = %s, want null", nullBytes)
+	}
+}
+
+func TestStringified_UnmarshalQuoted(t *testing.T) {
+	var got Stringified[int64]
+	if err := got.UnmarshalJSON([]byte(`"42"`)); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if got.RawValue() != 42 {
+		t.Errorf("UnmarshalJSON() = %d, want 42", got.RawValue())
+	}
+}
+
+func TestStringified_UnmarshalBareNumber(t *testing.T) {
+	var got Stringified[int64]
+	if err := got.UnmarshalJSON([]byte(`42`)); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if got.RawValue() != 42 {
+		t.Errorf("UnmarshalJSON() = %d, want 42", got.RawValue())
+	}
+}
+
+func TestStringified_UnmarshalNull(t *testing.T) {
+	var got Stringified[int64]
+	got.SetValue(7)
+
+	if err := got.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if !got.IsNull() {
+		t.Errorf("UnmarshalJSON(null) should mark the value null")
+	}
+}
+
+func TestStringified_UnmarshalRejectsInvalidToken(t *testing.T) {
+	var got Stringified[int64]
+	if err := got.UnmarshalJSON([]byte("true")); err == nil {
+		t.Errorf("UnmarshalJSON(true) should reject a non-numeric token instead of silently swallowing it")
+	}
+}
+
+func TestStringified_SQL(t *testing.T) {
+	n := StringifiedValue(int64(42))
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+	if v != int64(42) {
+		t.Errorf("Value() = %v, want 42", v)
+	}
+
+	var got Stringified[int64]
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	if got.RawValue() != 42 {
+		t.Errorf("Scan() = %d, want 42", got.RawValue())
+	}
+}
@@ -0,0 +1,272 @@
+package null
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"time"
+)
+
+var (
+	// TimeLayout is the layout used by NullableTime when marshaling to/from JSON.
+	// Defaults to time.RFC3339. Changing it affects every NullableTime value.
+	TimeLayout = time.RFC3339
+)
+
+type (
+	// NullableTime is a nullable time.Time that marshals to/from JSON as a string formatted
+	// according to TimeLayout, instead of the fixed RFC3339 layout used by Nullable[time.Time].
+	NullableTime struct {
+		Type[time.Time]
+	}
+
+	// UnixMilli is a nullable time.Time that marshals to/from JSON as an integer number of
+	// milliseconds since the Unix epoch, and Scans/Values as int64 for SQL.
+	UnixMilli struct {
+		Type[time.Time]
+	}
+
+	// UnixSeconds is a nullable time.Time that marshals to/from JSON as an integer number of
+	// seconds since the Unix epoch, and Scans/Values as int64 for SQL.
+	UnixSeconds struct {
+		Type[time.Time]
+	}
+)
+
+// NullableTimeValue returns not null NullableTime with value.
+func NullableTimeValue(value time.Time) NullableTime {
+	return NullableTime{
+		Type: Type[time.Time]{
+			value: value,
+			ok:    true,
+		},
+	}
+}
+
+// NullableTimeValueFromPtr returns null NullableTime if valuePtr is nil, NullableTime with actual value otherwise.
+func NullableTimeValueFromPtr(valuePtr *time.Time) NullableTime {
+	if valuePtr == nil {
+		return NullableTime{}
+	}
+
+	return NullableTime{
+		Type: Type[time.Time]{
+			value: *valuePtr,
+			ok:    true,
+		},
+	}
+}
+
+func (t NullableTime) MarshalJSON() ([]byte, error) {
+	if !t.ok {
+		return nullBytes, nil
+	}
+
+	return strconv.AppendQuote(nil, t.value.Format(TimeLayout)), nil
+}
+
+func (t *NullableTime) UnmarshalJSON(bytes []byte) error {
+	if string(bytes) == nullString {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	s, err := strconv.Unquote(string(bytes))
+	if err != nil {
+		return err
+	}
+
+	v, err := time.Parse(TimeLayout, s)
+	if err != nil {
+		return err
+	}
+
+	t.value = v
+	t.ok = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (t NullableTime) Value() (driver.Value, error) {
+	if !t.ok {
+		return nil, nil
+	}
+
+	return t.value, nil
+}
+
+// Scan implements sql.Scanner.
+func (t *NullableTime) Scan(src any) error {
+	if src == nil {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	v, ok := src.(time.Time)
+	if !ok {
+		return ErrScanningTypeMismatch
+	}
+
+	t.value = v
+	t.ok = true
+
+	return nil
+}
+
+// UnixMilliValue returns not null UnixMilli with value.
+func UnixMilliValue(value time.Time) UnixMilli {
+	return UnixMilli{
+		Type: Type[time.Time]{
+			value: value,
+			ok:    true,
+		},
+	}
+}
+
+// UnixMilliValueFromPtr returns null UnixMilli if valuePtr is nil, UnixMilli with actual value otherwise.
+func UnixMilliValueFromPtr(valuePtr *time.Time) UnixMilli {
+	if valuePtr == nil {
+		return UnixMilli{}
+	}
+
+	return UnixMilli{
+		Type: Type[time.Time]{
+			value: *valuePtr,
+			ok:    true,
+		},
+	}
+}
+
+func (t UnixMilli) MarshalJSON() ([]byte, error) {
+	if !t.ok {
+		return nullBytes, nil
+	}
+
+	return strconv.AppendInt(nil, t.value.UnixMilli(), 10), nil
+}
+
+func (t *UnixMilli) UnmarshalJSON(bytes []byte) error {
+	if string(bytes) == nullString {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	ms, err := strconv.ParseInt(string(bytes), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	t.value = time.UnixMilli(ms)
+	t.ok = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (t UnixMilli) Value() (driver.Value, error) {
+	if !t.ok {
+		return nil, nil
+	}
+
+	return t.value.UnixMilli(), nil
+}
+
+// Scan implements sql.Scanner.
+func (t *UnixMilli) Scan(src any) error {
+	if src == nil {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	ms, ok := src.(int64)
+	if !ok {
+		return ErrScanningTypeMismatch
+	}
+
+	t.value = time.UnixMilli(ms)
+	t.ok = true
+
+	return nil
+}
+
+// UnixSecondsValue returns not null UnixSeconds with value.
+func UnixSecondsValue(value time.Time) UnixSeconds {
+	return UnixSeconds{
+		Type: Type[time.Time]{
+			value: value,
+			ok:    true,
+		},
+	}
+}
+
+// UnixSecondsValueFromPtr returns null UnixSeconds if valuePtr is nil, UnixSeconds with actual value otherwise.
+func UnixSecondsValueFromPtr(valuePtr *time.Time) UnixSeconds {
+	if valuePtr == nil {
+		return UnixSeconds{}
+	}
+
+	return UnixSeconds{
+		Type: Type[time.Time]{
+			value: *valuePtr,
+			ok:    true,
+		},
+	}
+}
+
+func (t UnixSeconds) MarshalJSON() ([]byte, error) {
+	if !t.ok {
+		return nullBytes, nil
+	}
+
+	return strconv.AppendInt(nil, t.value.Unix(), 10), nil
+}
+
+func (t *UnixSeconds) UnmarshalJSON(bytes []byte) error {
+	if string(bytes) == nullString {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	s, err := strconv.ParseInt(string(bytes), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	t.value = time.Unix(s, 0)
+	t.ok = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (t UnixSeconds) Value() (driver.Value, error) {
+	if !t.ok {
+		return nil, nil
+	}
+
+	return t.value.Unix(), nil
+}
+
+// Scan implements sql.Scanner.
+func (t *UnixSeconds) Scan(src any) error {
+	if src == nil {
+		t.value = t.DefaultValue()
+		t.ok = false
+		return nil
+	}
+
+	s, ok := src.(int64)
+	if !ok {
+		return ErrScanningTypeMismatch
+	}
+
+	t.value = time.Unix(s, 0)
+	t.ok = true
+
+	return nil
+}
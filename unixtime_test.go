@@ -0,0 +1,148 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnixMilli_JSON(t *testing.T) {
+	ts := time.UnixMilli(1689595800123)
+	n := UnixMilliValue(ts)
+
+	b, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(b) != "1689595800123" {
+		t.Errorf("MarshalJSON() = %s, want 1689595800123", b)
+	}
+
+	var got UnixMilli
+	if err := got.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got.RawValue(), ts)
+	}
+
+	var null UnixMilli
+	nullBytes, err := null.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(nullBytes) != "null" {
+		t.Errorf("MarshalJSON() of a null value = %s, want null", nullBytes)
+	}
+
+	var roundTripped UnixMilli
+	if err := roundTripped.UnmarshalJSON(nullBytes); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if !roundTripped.IsNull() {
+		t.Errorf("UnmarshalJSON(null) should mark the value null")
+	}
+}
+
+func TestUnixMilli_SQL(t *testing.T) {
+	ts := time.UnixMilli(1689595800123)
+	n := UnixMilliValue(ts)
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+	if v != ts.UnixMilli() {
+		t.Errorf("Value() = %v, want %d", v, ts.UnixMilli())
+	}
+
+	var got UnixMilli
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("Scan() = %v, want %v", got.RawValue(), ts)
+	}
+
+	if err := got.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) error: %v", err)
+	}
+	if !got.IsNull() {
+		t.Errorf("Scan(nil) should mark the value null")
+	}
+}
+
+func TestUnixSeconds_JSON(t *testing.T) {
+	ts := time.Unix(1689595800, 0)
+	n := UnixSecondsValue(ts)
+
+	b, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(b) != "1689595800" {
+		t.Errorf("MarshalJSON() = %s, want 1689595800", b)
+	}
+
+	var got UnixSeconds
+	if err := got.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got.RawValue(), ts)
+	}
+}
+
+func TestUnixSeconds_SQL(t *testing.T) {
+	ts := time.Unix(1689595800, 0)
+	n := UnixSecondsValue(ts)
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+	if v != ts.Unix() {
+		t.Errorf("Value() = %v, want %d", v, ts.Unix())
+	}
+
+	var got UnixSeconds
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("Scan() = %v, want %v", got.RawValue(), ts)
+	}
+}
+
+func TestNullableTime_CustomLayout(t *testing.T) {
+	old := TimeLayout
+	TimeLayout = "2006-01-02"
+	defer func() { TimeLayout = old }()
+
+	ts := time.Date(2023, 7, 17, 0, 0, 0, 0, time.UTC)
+	n := NullableTimeValue(ts)
+
+	b, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(b) != `"2023-07-17"` {
+		t.Errorf("MarshalJSON() = %s, want \"2023-07-17\"", b)
+	}
+
+	var got NullableTime
+	if err := got.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v", err)
+	}
+	if !got.RawValue().Equal(ts) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got.RawValue(), ts)
+	}
+
+	var null NullableTime
+	nullBytes, err := null.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v", err)
+	}
+	if string(nullBytes) != "null" {
+		t.Errorf("MarshalJSON() of a null value = %s, want null", nullBytes)
+	}
+}
@@ -4,11 +4,17 @@ import "errors"
 
 const (
 	nullString = "null"
+
+	binaryTagNull  byte = 0x00
+	binaryTagValue byte = 0x01
 )
 
 var (
 	nullBytes = []byte(nullString)
 
-	ErrScanningTypeMismatch = errors.New("scanning type mismatch")
-	ErrTypeIsNotSupported   = errors.New("type is not supported by driver.Valuer")
+	ErrScanningTypeMismatch  = errors.New("scanning type mismatch")
+	ErrTypeIsNotSupported    = errors.New("type is not supported by driver.Valuer")
+	ErrBinaryDataIsEmpty     = errors.New("binary data is empty")
+	ErrUnknownBinaryTag      = errors.New("unknown binary tag")
+	ErrInvalidPgArrayLiteral = errors.New("invalid postgres array literal")
 )